@@ -0,0 +1,279 @@
+// Package api talks to the GitHub GraphQL API and shapes the responses into
+// the types the ui package renders.
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DateLayout is the YYYY-MM-DD layout the GitHub GraphQL API uses for
+// contribution dates.
+const (
+	DateLayout = "2006-01-02"
+	gitHubAPI  = "https://api.github.com/graphql"
+
+	// maxQueryWindow is the longest date range GitHub's
+	// contributionsCollection field accepts in a single query. Ranges
+	// longer than this are chunked into successive queries and merged.
+	maxQueryWindow = 365 * 24 * time.Hour
+)
+
+// Day pairs a calendar date with its contribution count.
+type Day struct {
+	Date  time.Time
+	Count int
+}
+
+// Calendar holds a user's contribution counts over an arbitrary date
+// range, keyed by day so it isn't tied to any fixed grid shape.
+type Calendar struct {
+	From   time.Time
+	To     time.Time
+	Counts map[string]int // DateLayout-formatted date -> contribution count
+}
+
+// Count returns the contribution count recorded for date, or 0 if none was
+// fetched.
+func (c Calendar) Count(date time.Time) int {
+	return c.Counts[date.Format(DateLayout)]
+}
+
+// Days returns every date in the calendar with a non-zero count, sorted
+// chronologically.
+func (c Calendar) Days() []Day {
+	days := make([]Day, 0, len(c.Counts))
+	for date, count := range c.Counts {
+		if count == 0 {
+			continue
+		}
+		parsed, err := time.Parse(DateLayout, date)
+		if err != nil {
+			continue
+		}
+		days = append(days, Day{Date: parsed, Count: count})
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+	return days
+}
+
+// RepositoryActivity is the per-repository breakdown of a single day's
+// contributions, used by the day-detail drill-down screen.
+type RepositoryActivity struct {
+	Name         string
+	Commits      int
+	PullRequests int
+	Issues       int
+}
+
+// DayDetail is the result of drilling down into a single day of the
+// calendar.
+type DayDetail struct {
+	Date         time.Time
+	Repositories []RepositoryActivity
+}
+
+// Client is a GitHub GraphQL client used to fetch contribution data. It
+// wraps an *http.Client so callers can inject a fake transport in tests.
+type Client struct {
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient returns a Client that authenticates with token using the
+// default HTTP client.
+func NewClient(token string) *Client {
+	return NewClientWithHTTPClient(token, http.DefaultClient)
+}
+
+// NewClientWithHTTPClient returns a Client using httpClient instead of the
+// default, so tests can inject a fake transport (e.g. via gock).
+func NewClientWithHTTPClient(token string, httpClient *http.Client) *Client {
+	return &Client{httpClient: httpClient, token: token}
+}
+
+// FetchContributions fetches the contributions data for the given GitHub
+// username over the past year.
+func (c *Client) FetchContributions(username string) (Calendar, error) {
+	to := time.Now()
+	from := to.AddDate(-1, 0, 0)
+	return c.FetchContributionsRange(username, from, to)
+}
+
+// FetchContributionsRange fetches username's contributions between from and
+// to (inclusive). Ranges longer than GitHub's one-year
+// contributionsCollection limit are split into successive queries and
+// merged into a single Calendar.
+func (c *Client) FetchContributionsRange(username string, from, to time.Time) (Calendar, error) {
+	calendar := Calendar{From: from, To: to, Counts: make(map[string]int)}
+
+	windowStart := from
+	for !windowStart.After(to) {
+		// The query runs through the end of windowEnd's day, so stop a day
+		// short to keep the whole query within maxQueryWindow.
+		windowEnd := windowStart.Add(maxQueryWindow).AddDate(0, 0, -1)
+		if windowEnd.After(to) {
+			windowEnd = to
+		}
+
+		days, err := c.fetchContributionDays(username, windowStart, windowEnd)
+		if err != nil {
+			return calendar, err
+		}
+		for _, day := range days {
+			calendar.Counts[day.Date] = day.ContributionCount
+		}
+
+		windowStart = windowEnd.AddDate(0, 0, 1)
+	}
+	return calendar, nil
+}
+
+// contributionDay is the raw per-day shape returned by the
+// contributionCalendar GraphQL field.
+type contributionDay struct {
+	Date              string
+	ContributionCount int
+}
+
+// fetchContributionDays issues a single contributionsCollection query
+// covering [from, to] and returns the raw per-day counts.
+func (c *Client) fetchContributionDays(username string, from, to time.Time) ([]contributionDay, error) {
+	query := fmt.Sprintf(
+		`{ "query": "query { user(login: \"%s\") { contributionsCollection(from: \"%sT00:00:00Z\", to: \"%sT23:59:59Z\") { contributionCalendar { weeks { contributionDays { date, contributionCount } } } } } }" }`,
+		username, from.Format(DateLayout), to.Format(DateLayout),
+	)
+
+	body, err := c.post(query)
+	if err != nil {
+		return nil, err
+	}
+	return parseContributionDays(body)
+}
+
+// parseContributionDays decodes a contributionsCollection GraphQL
+// response body into its flattened per-day counts. It's shared by the
+// live fetch path and by LoadFixtureCalendar, which replays a saved
+// response for offline use.
+func parseContributionDays(body []byte) ([]contributionDay, error) {
+	var resp struct {
+		Data struct {
+			User struct {
+				ContributionsCollection struct {
+					ContributionCalendar struct {
+						Weeks []struct {
+							ContributionDays []contributionDay
+						}
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	var days []contributionDay
+	for _, week := range resp.Data.User.ContributionsCollection.ContributionCalendar.Weeks {
+		days = append(days, week.ContributionDays...)
+	}
+	return days, nil
+}
+
+// FetchDayDetail fetches the per-repository commit, pull request, and issue
+// breakdown for username on the given date, for use by the day-detail
+// drill-down screen.
+func (c *Client) FetchDayDetail(username string, date time.Time) (DayDetail, error) {
+	detail := DayDetail{Date: date}
+
+	from := date.Format(DateLayout)
+	to := date.Format(DateLayout)
+
+	query := fmt.Sprintf(
+		`{ "query": "query { user(login: \"%s\") { contributionsCollection(from: \"%sT00:00:00Z\", to: \"%sT23:59:59Z\") { commitContributionsByRepository(maxRepositories: 25) { repository { nameWithOwner } contributions { totalCount } } pullRequestContributionsByRepository(maxRepositories: 25) { repository { nameWithOwner } contributions { totalCount } } issueContributionsByRepository(maxRepositories: 25) { repository { nameWithOwner } contributions { totalCount } } } } }" }`,
+		username, from, to,
+	)
+
+	body, err := c.post(query)
+	if err != nil {
+		return detail, err
+	}
+
+	type repoContribution struct {
+		Repository struct {
+			NameWithOwner string
+		}
+		Contributions struct {
+			TotalCount int
+		}
+	}
+	var resp struct {
+		Data struct {
+			User struct {
+				ContributionsCollection struct {
+					CommitContributionsByRepository      []repoContribution
+					PullRequestContributionsByRepository []repoContribution
+					IssueContributionsByRepository       []repoContribution
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return detail, err
+	}
+
+	byRepo := make(map[string]*RepositoryActivity)
+	activity := func(name string) *RepositoryActivity {
+		a, ok := byRepo[name]
+		if !ok {
+			a = &RepositoryActivity{Name: name}
+			byRepo[name] = a
+		}
+		return a
+	}
+	for _, rc := range resp.Data.User.ContributionsCollection.CommitContributionsByRepository {
+		activity(rc.Repository.NameWithOwner).Commits += rc.Contributions.TotalCount
+	}
+	for _, rc := range resp.Data.User.ContributionsCollection.PullRequestContributionsByRepository {
+		activity(rc.Repository.NameWithOwner).PullRequests += rc.Contributions.TotalCount
+	}
+	for _, rc := range resp.Data.User.ContributionsCollection.IssueContributionsByRepository {
+		activity(rc.Repository.NameWithOwner).Issues += rc.Contributions.TotalCount
+	}
+	for _, a := range byRepo {
+		detail.Repositories = append(detail.Repositories, *a)
+	}
+	return detail, nil
+}
+
+// post sends a raw GraphQL query body to the GitHub API and returns the
+// response body bytes. A non-2xx status is reported as an error rather
+// than silently handed to the caller for JSON decoding.
+func (c *Client) post(query string) ([]byte, error) {
+	req, err := http.NewRequest("POST", gitHubAPI, strings.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", "bearer "+c.token)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("github api returned %s: %s", res.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+)
+
+// newTestClient returns a Client whose requests are intercepted by gock,
+// and registers cleanup to restore the real transport afterwards.
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	httpClient := &http.Client{}
+	gock.InterceptClient(httpClient)
+	t.Cleanup(func() {
+		gock.Off()
+		gock.RestoreClient(httpClient)
+	})
+	return NewClientWithHTTPClient("test-token", httpClient)
+}
+
+func mustParseDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	date, err := time.Parse(DateLayout, s)
+	if err != nil {
+		t.Fatalf("parsing date %q: %v", s, err)
+	}
+	return date
+}
+
+func weeksResponse(days ...map[string]any) map[string]any {
+	return map[string]any{
+		"data": map[string]any{
+			"user": map[string]any{
+				"contributionsCollection": map[string]any{
+					"contributionCalendar": map[string]any{
+						"weeks": []map[string]any{
+							{"contributionDays": anySlice(days)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func anySlice(days []map[string]any) []any {
+	out := make([]any, len(days))
+	for i, d := range days {
+		out[i] = d
+	}
+	return out
+}
+
+func TestFetchContributionsRange_QueryAndAuth(t *testing.T) {
+	client := newTestClient(t)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchHeader("Authorization", "^bearer test-token$").
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			getBody, err := req.GetBody()
+			if err != nil {
+				return false, err
+			}
+			body, err := io.ReadAll(getBody)
+			if err != nil {
+				return false, err
+			}
+			return strings.Contains(string(body), "octocat") &&
+				strings.Contains(string(body), "2024-01-01") &&
+				strings.Contains(string(body), "2024-01-02"), nil
+		}).
+		Reply(200).
+		JSON(weeksResponse(
+			map[string]any{"date": "2024-01-01", "contributionCount": 3},
+		))
+
+	from, to := mustParseDate(t, "2024-01-01"), mustParseDate(t, "2024-01-02")
+	if _, err := client.FetchContributionsRange("octocat", from, to); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gock.IsDone() {
+		t.Fatal("expected request was not made")
+	}
+}
+
+func TestFetchContributionsRange_MapsPartialWeeks(t *testing.T) {
+	client := newTestClient(t)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		JSON(weeksResponse(
+			map[string]any{"date": "2024-01-01", "contributionCount": 5},
+			map[string]any{"date": "2024-01-03", "contributionCount": 2},
+		))
+
+	from, to := mustParseDate(t, "2024-01-01"), mustParseDate(t, "2024-01-07")
+	calendar, err := client.FetchContributionsRange("octocat", from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calendar.Count(mustParseDate(t, "2024-01-01")); got != 5 {
+		t.Errorf("Count(2024-01-01) = %d, want 5", got)
+	}
+	if got := calendar.Count(mustParseDate(t, "2024-01-03")); got != 2 {
+		t.Errorf("Count(2024-01-03) = %d, want 2", got)
+	}
+	if got := calendar.Count(mustParseDate(t, "2024-01-02")); got != 0 {
+		t.Errorf("Count(2024-01-02) = %d, want 0 for a day missing from the response", got)
+	}
+}
+
+func TestFetchContributionsRange_Unauthorized(t *testing.T) {
+	client := newTestClient(t)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(401).
+		JSON(map[string]string{"message": "Bad credentials"})
+
+	_, err := client.FetchContributionsRange("octocat", mustParseDate(t, "2024-01-01"), mustParseDate(t, "2024-01-02"))
+	if err == nil {
+		t.Fatal("expected an error for a 401 response, got nil")
+	}
+}
+
+func TestFetchContributionsRange_ServerError(t *testing.T) {
+	client := newTestClient(t)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(500).
+		BodyString("internal server error")
+
+	_, err := client.FetchContributionsRange("octocat", mustParseDate(t, "2024-01-01"), mustParseDate(t, "2024-01-02"))
+	if err == nil {
+		t.Fatal("expected an error for a 500 response, got nil")
+	}
+}
+
+func TestFetchContributionsRange_MalformedJSON(t *testing.T) {
+	client := newTestClient(t)
+
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		Reply(200).
+		BodyString("{not valid json")
+
+	_, err := client.FetchContributionsRange("octocat", mustParseDate(t, "2024-01-01"), mustParseDate(t, "2024-01-02"))
+	if err == nil {
+		t.Fatal("expected an error for a malformed JSON response, got nil")
+	}
+}
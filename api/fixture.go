@@ -0,0 +1,28 @@
+package api
+
+import (
+	"os"
+	"time"
+)
+
+// LoadFixtureCalendar reads a saved contributionsCollection GraphQL
+// response from path and builds a Calendar from it, bypassing the network
+// entirely. This backs the --offline flag so the TUI can be demoed or
+// screenshot-tested without a token.
+func LoadFixtureCalendar(path string, from, to time.Time) (Calendar, error) {
+	calendar := Calendar{From: from, To: to, Counts: make(map[string]int)}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return calendar, err
+	}
+
+	days, err := parseContributionDays(data)
+	if err != nil {
+		return calendar, err
+	}
+	for _, day := range days {
+		calendar.Counts[day.Date] = day.ContributionCount
+	}
+	return calendar, nil
+}
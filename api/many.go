@@ -0,0 +1,35 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// FetchMany concurrently fetches contribution calendars for usernames over
+// [from, to], used by the compare screen and compare subcommand.
+func (c *Client) FetchMany(usernames []string, from, to time.Time) (map[string]Calendar, error) {
+	results := make(map[string]Calendar, len(usernames))
+	var mu sync.Mutex
+
+	var g errgroup.Group
+	for _, username := range usernames {
+		username := username
+		g.Go(func() error {
+			calendar, err := c.FetchContributionsRange(username, from, to)
+			if err != nil {
+				return fmt.Errorf("%s: %w", username, err)
+			}
+			mu.Lock()
+			results[username] = calendar
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
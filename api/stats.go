@@ -0,0 +1,67 @@
+package api
+
+import "fmt"
+
+// Stats summarizes a Calendar's contribution activity, used by the
+// compare screen and the compare subcommand.
+type Stats struct {
+	Total         int
+	LongestStreak int
+	CurrentStreak int
+	BestDay       Day
+}
+
+// BestDayString formats BestDay for display, reporting "none" instead of
+// the zero date when the calendar has no recorded contributions.
+func (s Stats) BestDayString() string {
+	if s.BestDay.Count == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%s (%d)", s.BestDay.Date.Format(DateLayout), s.BestDay.Count)
+}
+
+// Stats computes aggregate statistics over the calendar's full date
+// range, counting every day (including those with no recorded activity)
+// so streaks are measured correctly.
+func (c Calendar) Stats() Stats {
+	var stats Stats
+	streak := 0
+	for d := c.From; !d.After(c.To); d = d.AddDate(0, 0, 1) {
+		count := c.Count(d)
+		stats.Total += count
+		if count > stats.BestDay.Count {
+			stats.BestDay = Day{Date: d, Count: count}
+		}
+		if count > 0 {
+			streak++
+		} else {
+			streak = 0
+		}
+		if streak > stats.LongestStreak {
+			stats.LongestStreak = streak
+		}
+	}
+	stats.CurrentStreak = currentStreak(c)
+	return stats
+}
+
+// currentStreak counts consecutive contribution days ending at the
+// calendar's last day, working backwards. The last day itself is skipped
+// if it has no recorded activity yet, so an in-progress streak isn't
+// reported as broken before today has even ended.
+func currentStreak(c Calendar) int {
+	d := c.To
+	if c.Count(d) == 0 {
+		d = d.AddDate(0, 0, -1)
+	}
+
+	streak := 0
+	for !d.Before(c.From) {
+		if c.Count(d) == 0 {
+			break
+		}
+		streak++
+		d = d.AddDate(0, 0, -1)
+	}
+	return streak
+}
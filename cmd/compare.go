@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+)
+
+var (
+	compareUsers string
+	compareFrom  string
+	compareTo    string
+)
+
+// compareCmd concurrently fetches and prints a table of contribution
+// totals and streaks for multiple users.
+var compareCmd = &cobra.Command{
+	Use:   "compare",
+	Short: "Compare contribution calendars across multiple users",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		usernames := splitUsernames(compareUsers)
+		if len(usernames) == 0 {
+			return fmt.Errorf("--users is required")
+		}
+
+		from, to, err := parseRange(compareFrom, compareTo)
+		if err != nil {
+			return err
+		}
+
+		token, err := resolveToken(tokenFlag)
+		if err != nil {
+			return err
+		}
+
+		client := api.NewClient(token)
+		calendars, err := client.FetchMany(usernames, from, to)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "user\ttotal\tlongest streak\tcurrent streak\tbest day")
+		for _, username := range usernames {
+			stats := calendars[username].Stats()
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n",
+				username, stats.Total, stats.LongestStreak, stats.CurrentStreak,
+				stats.BestDayString())
+		}
+		return w.Flush()
+	},
+}
+
+// splitUsernames parses a comma-separated username list, trimming
+// whitespace and dropping empty entries.
+func splitUsernames(raw string) []string {
+	var usernames []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			usernames = append(usernames, part)
+		}
+	}
+	return usernames
+}
+
+func init() {
+	compareCmd.Flags().StringVar(&compareUsers, "users", "", "comma-separated GitHub usernames to compare (required)")
+	compareCmd.Flags().StringVar(&compareFrom, "from", "", "start date, YYYY-MM-DD (default: one year before --to)")
+	compareCmd.Flags().StringVar(&compareTo, "to", "", "end date, YYYY-MM-DD (default: today)")
+	rootCmd.AddCommand(compareCmd)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+	"github.com/jvanrhyn/github-contributions-tui/export"
+)
+
+var (
+	fetchUser   string
+	fetchFrom   string
+	fetchTo     string
+	fetchFormat string
+)
+
+// fetchCmd fetches a user's contribution calendar non-interactively and
+// writes it to stdout in the requested format.
+var fetchCmd = &cobra.Command{
+	Use:   "fetch",
+	Short: "Fetch a user's contributions and print them as json, csv, or svg",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fetchUser == "" {
+			return fmt.Errorf("--user is required")
+		}
+
+		from, to, err := parseRange(fetchFrom, fetchTo)
+		if err != nil {
+			return err
+		}
+
+		token, err := resolveToken(tokenFlag)
+		if err != nil {
+			return err
+		}
+
+		client := api.NewClient(token)
+		calendar, err := client.FetchContributionsRange(fetchUser, from, to)
+		if err != nil {
+			return err
+		}
+		return export.Write(os.Stdout, calendar, fetchFormat)
+	},
+}
+
+func init() {
+	fetchCmd.Flags().StringVar(&fetchUser, "user", "", "GitHub username to fetch (required)")
+	fetchCmd.Flags().StringVar(&fetchFrom, "from", "", "start date, YYYY-MM-DD (default: one year before --to)")
+	fetchCmd.Flags().StringVar(&fetchTo, "to", "", "end date, YYYY-MM-DD (default: today)")
+	fetchCmd.Flags().StringVar(&fetchFormat, "format", export.FormatJSON, "output format: json, csv, or svg")
+	rootCmd.AddCommand(fetchCmd)
+}
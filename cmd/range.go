@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+)
+
+// parseRange resolves --from/--to flag values (either of which may be
+// empty) into a concrete [from, to] window, defaulting to the past year
+// ending today.
+func parseRange(from, to string) (time.Time, time.Time, error) {
+	end := time.Now()
+	if to != "" {
+		parsed, err := time.Parse(api.DateLayout, to)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --to date: %w", err)
+		}
+		end = parsed
+	}
+
+	start := end.AddDate(-1, 0, 0)
+	if from != "" {
+		parsed, err := time.Parse(api.DateLayout, from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --from date: %w", err)
+		}
+		start = parsed
+	}
+
+	return start, end, nil
+}
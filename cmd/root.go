@@ -0,0 +1,84 @@
+// Package cmd wires the Cobra CLI: the root command launches the
+// interactive TUI, while fetch and compare offer non-interactive
+// alternatives for scripting.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	bubbletea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/jvanrhyn/github-contributions-tui/config"
+	"github.com/jvanrhyn/github-contributions-tui/ui"
+)
+
+var (
+	tokenFlag   string
+	rootFrom    string
+	rootTo      string
+	rootOffline string
+)
+
+// rootCmd is the base command; running it with no subcommand launches the
+// interactive TUI, preserving the original default behavior.
+var rootCmd = &cobra.Command{
+	Use:   "github-contributions-tui",
+	Short: "Browse GitHub contribution calendars",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, to, err := parseRange(rootFrom, rootTo)
+		if err != nil {
+			return err
+		}
+		return runTUI(from, to, rootOffline)
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&tokenFlag, "token", "", "GitHub token (overrides config file and GITHUB_TOKEN)")
+	rootCmd.Flags().StringVar(&rootFrom, "from", "", "initial window start date, YYYY-MM-DD (default: one year before --to)")
+	rootCmd.Flags().StringVar(&rootTo, "to", "", "initial window end date, YYYY-MM-DD (default: today)")
+	rootCmd.Flags().StringVar(&rootOffline, "offline", "", "replay a saved GraphQL response fixture instead of calling the API")
+}
+
+// Execute runs the root command, exiting the process on error.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// runTUI starts the Bubble Tea program with the given initial date window.
+// If offlineFixture is set, no token is required: screens replay the
+// fixture instead of calling the API.
+func runTUI(from, to time.Time, offlineFixture string) error {
+	var token string
+	if offlineFixture == "" {
+		resolved, err := resolveToken(tokenFlag)
+		if err != nil {
+			return err
+		}
+		token = resolved
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	ui.ApplyTheme(cfg.Theme)
+
+	state := config.NewAppState(token, from, to, cfg)
+	state.OfflineFixture = offlineFixture
+
+	var themeCh <-chan config.Theme
+	if path, err := config.Path(); err == nil {
+		themeCh = config.WatchTheme(path)
+	}
+
+	p := bubbletea.NewProgram(ui.NewApp(state, themeCh), bubbletea.WithAltScreen())
+	_, err = p.Run()
+	return err
+}
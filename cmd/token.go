@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// resolveToken returns the --token flag if set, and otherwise the token read
+// from the config file's token_source (by default the GITHUB_TOKEN
+// environment variable). GITHUB_TOKEN is not consulted when token_source
+// names another source.
+func resolveToken(flagToken string) (string, error) {
+	if flagToken != "" {
+		return flagToken, nil
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return "", err
+	}
+	token, err := cfg.Token()
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		path, _ := config.Path()
+		return "", fmt.Errorf("no GitHub token found: set one via --token, GITHUB_TOKEN, or token_source in %s", path)
+	}
+	return token, nil
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var (
+	tuiFrom    string
+	tuiTo      string
+	tuiOffline string
+)
+
+// tuiCmd explicitly launches the interactive browser; it's what rootCmd
+// runs when invoked with no subcommand.
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Launch the interactive contributions browser (default)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, to, err := parseRange(tuiFrom, tuiTo)
+		if err != nil {
+			return err
+		}
+		return runTUI(from, to, tuiOffline)
+	},
+}
+
+func init() {
+	tuiCmd.Flags().StringVar(&tuiFrom, "from", "", "initial window start date, YYYY-MM-DD (default: one year before --to)")
+	tuiCmd.Flags().StringVar(&tuiTo, "to", "", "initial window end date, YYYY-MM-DD (default: today)")
+	tuiCmd.Flags().StringVar(&tuiOffline, "offline", "", "replay a saved GraphQL response fixture instead of calling the API")
+	rootCmd.AddCommand(tuiCmd)
+}
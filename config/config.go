@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TokenSource describes where the GitHub token should be read from.
+type TokenSource struct {
+	// Type is "env" (read EnvVar, default GITHUB_TOKEN), "file" (read
+	// Path), or "gh-cli" (shell out to `gh auth token`).
+	Type   string `yaml:"type"`
+	EnvVar string `yaml:"env_var"`
+	Path   string `yaml:"path"`
+}
+
+// Config is the persisted YAML configuration loaded from
+// $XDG_CONFIG_HOME/github-contributions-tui/config.yml.
+type Config struct {
+	// Users are saved usernames offered as shortcuts on the home menu.
+	Users       []string    `yaml:"users"`
+	DefaultUser string      `yaml:"default_user"`
+	Theme       Theme       `yaml:"theme"`
+	TokenSource TokenSource `yaml:"token_source"`
+}
+
+// Default is the configuration used when no config file exists.
+func Default() Config {
+	return Config{
+		Theme:       DefaultTheme(),
+		TokenSource: TokenSource{Type: "env", EnvVar: "GITHUB_TOKEN"},
+	}
+}
+
+// Path returns the path to the config file, honoring XDG_CONFIG_HOME and
+// falling back to ~/.config.
+func Path() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "github-contributions-tui", "config.yml"), nil
+}
+
+// Load reads the config file, layering it over Default so a partial file
+// (or none at all) still yields a usable Config.
+func Load() (Config, error) {
+	cfg := Default()
+
+	path, err := Path()
+	if err != nil {
+		return cfg, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Token resolves the GitHub token according to TokenSource.
+func (c Config) Token() (string, error) {
+	switch c.TokenSource.Type {
+	case "file":
+		data, err := os.ReadFile(c.TokenSource.Path)
+		if err != nil {
+			return "", fmt.Errorf("reading token file: %w", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case "gh-cli":
+		out, err := exec.Command("gh", "auth", "token").Output()
+		if err != nil {
+			return "", fmt.Errorf("running `gh auth token`: %w", err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	case "", "env":
+		envVar := c.TokenSource.EnvVar
+		if envVar == "" {
+			envVar = "GITHUB_TOKEN"
+		}
+		return os.Getenv(envVar), nil
+	default:
+		return "", fmt.Errorf("unknown token_source.type %q", c.TokenSource.Type)
+	}
+}
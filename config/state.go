@@ -0,0 +1,41 @@
+// Package config holds the application state shared across screens, plus
+// the persisted YAML configuration (saved profiles, theme, token source).
+package config
+
+import (
+	"time"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+)
+
+// AppState is passed to every screen model so it can read or update the
+// parts of the application's state relevant to it (the selected username,
+// the API client, the active date window, the loaded config, and so on).
+type AppState struct {
+	Username string
+	Client   *api.Client
+	Config   Config
+
+	// DefaultFrom and DefaultTo are the initial date window screens fetch
+	// when they're first shown; the calendar screen can shift or zoom this
+	// window afterwards.
+	DefaultFrom time.Time
+	DefaultTo   time.Time
+
+	// OfflineFixture, when non-empty, is a path to a saved GraphQL
+	// response; screens load it via api.LoadFixtureCalendar instead of
+	// calling Client, so the TUI can run without a token or network.
+	OfflineFixture string
+}
+
+// NewAppState returns the initial AppState for a program run with the
+// given GitHub token, default date window, and loaded config.
+func NewAppState(token string, from, to time.Time, cfg Config) *AppState {
+	return &AppState{
+		Client:      api.NewClient(token),
+		Config:      cfg,
+		Username:    cfg.DefaultUser,
+		DefaultFrom: from,
+		DefaultTo:   to,
+	}
+}
@@ -0,0 +1,21 @@
+package config
+
+// Theme holds the colors applied to the calendar grid's four contribution
+// intensity buckets (no contributions, low, mid, high), as hex strings
+// lipgloss can parse directly.
+type Theme struct {
+	None string `yaml:"none"`
+	Low  string `yaml:"low"`
+	Mid  string `yaml:"mid"`
+	High string `yaml:"high"`
+}
+
+// DefaultTheme is GitHub's own classic green palette.
+func DefaultTheme() Theme {
+	return Theme{
+		None: "#555555",
+		Low:  "#9be9a8",
+		Mid:  "#40c463",
+		High: "#216e39",
+	}
+}
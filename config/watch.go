@@ -0,0 +1,43 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchTheme watches the config file for changes and sends the reloaded
+// Theme on the returned channel each time it's written. If the watcher
+// can't be started (e.g. the config directory doesn't exist yet), the
+// channel is closed immediately; callers should treat that as "no live
+// reload available" rather than a fatal error.
+func WatchTheme(path string) <-chan Theme {
+	ch := make(chan Theme)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		close(ch)
+		return ch
+	}
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		close(ch)
+		return ch
+	}
+
+	go func() {
+		defer watcher.Close()
+		defer close(ch)
+		for event := range watcher.Events {
+			if event.Name != path || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			cfg, err := Load()
+			if err != nil {
+				continue
+			}
+			ch <- cfg.Theme
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,127 @@
+// Package export renders a fetched Calendar into the non-interactive
+// output formats supported by the fetch subcommand.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+)
+
+// Supported output formats.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+	FormatSVG  = "svg"
+)
+
+// Write renders calendar in the given format to w.
+func Write(w io.Writer, calendar api.Calendar, format string) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, calendar)
+	case FormatCSV:
+		return writeCSV(w, calendar)
+	case FormatSVG:
+		return writeSVG(w, calendar)
+	default:
+		return fmt.Errorf("unsupported format %q (want json, csv, or svg)", format)
+	}
+}
+
+func writeJSON(w io.Writer, calendar api.Calendar) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(calendar.Days())
+}
+
+func writeCSV(w io.Writer, calendar api.Calendar) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"date", "count"}); err != nil {
+		return err
+	}
+	for _, day := range calendar.Days() {
+		if err := writer.Write([]string{day.Date.Format(api.DateLayout), strconv.Itoa(day.Count)}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// SVG heatmap layout: 7 rows (Sun-Sat) by one column per week in the
+// calendar's range, matching github.com's own contribution graph. Unlike
+// github.com, the column count isn't capped at 53: --from/--to can span
+// more than a year, and every week in the range is rendered.
+const (
+	svgCellSize = 11
+	svgGap      = 3
+	svgRows     = 7
+)
+
+func writeSVG(w io.Writer, calendar api.Calendar) error {
+	if calendar.To.Before(calendar.From) {
+		_, err := fmt.Fprint(w, `<svg xmlns="http://www.w3.org/2000/svg"></svg>`+"\n")
+		return err
+	}
+
+	days := calendar.Days()
+	counts := make(map[string]int, len(days))
+	for _, d := range days {
+		counts[d.Date.Format(api.DateLayout)] = d.Count
+	}
+
+	start := calendar.From
+	for start.Weekday() != time.Sunday {
+		start = start.AddDate(0, 0, -1)
+	}
+	end := calendar.To
+
+	cols := int(end.Sub(start).Hours()/(24*7)) + 1
+
+	width := cols * (svgCellSize + svgGap)
+	height := svgRows * (svgCellSize + svgGap)
+
+	if _, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d">`+"\n", width, height); err != nil {
+		return err
+	}
+
+	day := start
+	for col := 0; col < cols && !day.After(end); col++ {
+		for row := 0; row < svgRows; row++ {
+			x := col * (svgCellSize + svgGap)
+			y := row * (svgCellSize + svgGap)
+			count := counts[day.Format(api.DateLayout)]
+			if _, err := fmt.Fprintf(w, `  <rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+				x, y, svgCellSize, svgCellSize, svgBucketColor(count)); err != nil {
+				return err
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+
+	_, err := fmt.Fprint(w, "</svg>\n")
+	return err
+}
+
+// svgBucketColor maps a contribution count to one of GitHub's classic
+// four-stop intensity colors.
+func svgBucketColor(count int) string {
+	switch {
+	case count == 0:
+		return "#ebedf0"
+	case count < 3:
+		return "#9be9a8"
+	case count < 6:
+		return "#40c463"
+	case count < 10:
+		return "#30a14e"
+	default:
+		return "#216e39"
+	}
+}
@@ -0,0 +1,88 @@
+// Package ui implements the Bubble Tea screens for github-contributions-tui.
+//
+// Each screen is its own tea.Model; App is a thin router that holds the
+// currently active screen and swaps it out in response to gotoMsg, so new
+// screens can be added without growing a single monolithic model.
+package ui
+
+import (
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// App routes between screens, keeping the active one in a tea.Model field.
+// It also listens on themeCh, if non-nil, to repaint the running program
+// whenever the config file's theme is edited.
+type App struct {
+	state   *config.AppState
+	active  bubbletea.Model
+	themeCh <-chan config.Theme
+}
+
+// NewApp returns the root App model, starting on the home menu. themeCh may
+// be nil if live theme reload isn't available.
+func NewApp(state *config.AppState, themeCh <-chan config.Theme) *App {
+	return &App{state: state, active: NewHomeModel(state), themeCh: themeCh}
+}
+
+// gotoMsg asks the router to switch the active screen to model.
+type gotoMsg struct {
+	model bubbletea.Model
+}
+
+// goTo returns a command that switches the active screen to model.
+func goTo(model bubbletea.Model) bubbletea.Cmd {
+	return func() bubbletea.Msg {
+		return gotoMsg{model: model}
+	}
+}
+
+// themeMsg carries a theme reloaded from disk, or ok=false if the watch
+// channel was closed.
+type themeMsg struct {
+	theme config.Theme
+	ok    bool
+}
+
+// watchThemeCmd waits for the next theme sent on ch.
+func watchThemeCmd(ch <-chan config.Theme) bubbletea.Cmd {
+	return func() bubbletea.Msg {
+		theme, ok := <-ch
+		return themeMsg{theme: theme, ok: ok}
+	}
+}
+
+// Init initializes the active screen and, if available, starts watching
+// for config file changes.
+func (a *App) Init() bubbletea.Cmd {
+	if a.themeCh == nil {
+		return a.active.Init()
+	}
+	return bubbletea.Batch(a.active.Init(), watchThemeCmd(a.themeCh))
+}
+
+// Update dispatches gotoMsg to switch screens and themeMsg to repaint,
+// otherwise forwards the message to the active screen.
+func (a *App) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	switch m := msg.(type) {
+	case gotoMsg:
+		a.active = m.model
+		return a, a.active.Init()
+	case themeMsg:
+		if !m.ok {
+			return a, nil
+		}
+		ApplyTheme(m.theme)
+		return a, watchThemeCmd(a.themeCh)
+	}
+
+	updated, cmd := a.active.Update(msg)
+	a.active = updated
+	return a, cmd
+}
+
+// View renders the active screen.
+func (a *App) View() string {
+	return a.active.View()
+}
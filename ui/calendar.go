@@ -0,0 +1,228 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// CalendarModel renders a fetched contribution calendar and lets the user
+// move a cursor across it to drill into a day. The date window can be
+// shifted by month or zoomed by year, and the layout can be toggled
+// between a month/day-of-month grid and a week-oriented view matching
+// github.com's own calendar.
+type CalendarModel struct {
+	state    *config.AppState
+	calendar api.Calendar
+	from, to time.Time
+	cursor   time.Time
+	weekView bool
+	loading  bool
+	err      error
+}
+
+// NewCalendarModel returns the calendar screen for the given contributions
+// data.
+func NewCalendarModel(state *config.AppState, calendar api.Calendar) *CalendarModel {
+	return &CalendarModel{
+		state:    state,
+		calendar: calendar,
+		from:     calendar.From,
+		to:       calendar.To,
+		cursor:   calendar.To,
+	}
+}
+
+// rangeMsg carries the result of re-fetching the calendar for a shifted or
+// zoomed date window.
+type rangeMsg struct {
+	calendar api.Calendar
+	err      error
+}
+
+// fetchRangeCmd fetches username's contributions over [from, to], replaying
+// state.OfflineFixture instead of calling the API if one is set.
+func fetchRangeCmd(state *config.AppState, username string, from, to time.Time) bubbletea.Cmd {
+	return func() bubbletea.Msg {
+		if state.OfflineFixture != "" {
+			calendar, err := api.LoadFixtureCalendar(state.OfflineFixture, from, to)
+			return rangeMsg{calendar: calendar, err: err}
+		}
+		calendar, err := state.Client.FetchContributionsRange(username, from, to)
+		return rangeMsg{calendar: calendar, err: err}
+	}
+}
+
+// Init implements bubbletea.Model.
+func (m *CalendarModel) Init() bubbletea.Cmd {
+	return nil
+}
+
+// Update implements bubbletea.Model.
+func (m *CalendarModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	switch msg := msg.(type) {
+	case bubbletea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, bubbletea.Quit
+		case "esc":
+			return m, goTo(NewHomeModel(m.state))
+		case "up", "k":
+			m.cursor = clampDate(m.cursor.AddDate(0, 0, -7), m.from, m.to)
+		case "down", "j":
+			m.cursor = clampDate(m.cursor.AddDate(0, 0, 7), m.from, m.to)
+		case "left", "h":
+			m.cursor = clampDate(m.cursor.AddDate(0, 0, -1), m.from, m.to)
+		case "right", "l":
+			m.cursor = clampDate(m.cursor.AddDate(0, 0, 1), m.from, m.to)
+		case "v":
+			m.weekView = !m.weekView
+		case "[":
+			return m, m.shiftWindow(-1)
+		case "]":
+			return m, m.shiftWindow(1)
+		case "{":
+			return m, m.zoom(1)
+		case "}":
+			return m, m.zoom(-1)
+		case "enter":
+			return m, goTo(NewDetailModel(m.state, m.cursor))
+		}
+	case rangeMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.calendar = msg.calendar
+		m.from, m.to = msg.calendar.From, msg.calendar.To
+		m.cursor = clampDate(m.cursor, m.from, m.to)
+	}
+	return m, nil
+}
+
+// shiftWindow moves the [from, to] window by months, keeping its length.
+func (m *CalendarModel) shiftWindow(months int) bubbletea.Cmd {
+	m.loading = true
+	from := m.from.AddDate(0, months, 0)
+	to := m.to.AddDate(0, months, 0)
+	return fetchRangeCmd(m.state, m.state.Username, from, to)
+}
+
+// zoom grows (years > 0) or shrinks (years < 0) the window by pushing from
+// back or forward, keeping to fixed.
+func (m *CalendarModel) zoom(years int) bubbletea.Cmd {
+	from := m.from.AddDate(-years, 0, 0)
+	if !from.Before(m.to) {
+		from = m.to.AddDate(0, -1, 0)
+	}
+	m.loading = true
+	return fetchRangeCmd(m.state, m.state.Username, from, m.to)
+}
+
+// clampDate restricts d to the inclusive range [lo, hi].
+func clampDate(d, lo, hi time.Time) time.Time {
+	if d.Before(lo) {
+		return lo
+	}
+	if d.After(hi) {
+		return hi
+	}
+	return d
+}
+
+// View implements bubbletea.Model.
+func (m *CalendarModel) View() string {
+	header := fmt.Sprintf("Contributions for %s\n\n", contributionStyle.Render(m.state.Username))
+	if m.loading {
+		return header + "Loading...\n"
+	}
+
+	var body string
+	if m.weekView {
+		body = m.renderWeekView()
+	} else {
+		body = m.renderMonthView()
+	}
+
+	footer := "\n(arrows to move, enter to drill in, v to toggle week view, [ ] to shift a month, { } to zoom a year, esc for home)\n"
+	if m.err != nil {
+		footer = errorStyle.Render(fmt.Sprintf("\nError: %v\n", m.err)) + footer
+	}
+	return header + body + footer
+}
+
+// renderMonthView lays out the window as one row per month, one column per
+// day-of-month, matching the original single-file layout.
+func (m *CalendarModel) renderMonthView() string {
+	var b strings.Builder
+	b.WriteString(darkGreyStyle.Render("        "))
+	for day := 1; day <= 31; day++ {
+		b.WriteString(lightGreyStyle.Render(fmt.Sprintf("%2d ", day)))
+	}
+	b.WriteString("\n")
+
+	monthStart := time.Date(m.from.Year(), m.from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for !monthStart.After(m.to) {
+		b.WriteString(lightGreyStyle.Render(monthStart.Format("2006-01")) + " ")
+		daysInMonth := monthStart.AddDate(0, 1, -1).Day()
+		for day := 1; day <= 31; day++ {
+			if day > daysInMonth {
+				b.WriteString("   ")
+				continue
+			}
+			date := time.Date(monthStart.Year(), monthStart.Month(), day, 0, 0, 0, 0, time.UTC)
+			b.WriteString(m.renderCell(date))
+		}
+		b.WriteString("\n")
+		monthStart = monthStart.AddDate(0, 1, 0)
+	}
+	return b.String()
+}
+
+// renderWeekView lays out the window as 7 rows (Sun-Sat) by one column per
+// week, matching github.com's own contribution calendar.
+func (m *CalendarModel) renderWeekView() string {
+	weekStart := m.from
+	for weekStart.Weekday() != time.Sunday {
+		weekStart = weekStart.AddDate(0, 0, -1)
+	}
+
+	weekdayLabels := []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	var b strings.Builder
+	for row := 0; row < 7; row++ {
+		b.WriteString(lightGreyStyle.Render(fmt.Sprintf("%3s ", weekdayLabels[row])))
+		for date := weekStart.AddDate(0, 0, row); !date.After(m.to); date = date.AddDate(0, 0, 7) {
+			if date.Before(m.from) {
+				b.WriteString("   ")
+				continue
+			}
+			b.WriteString(m.renderCell(date))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderCell formats a single day's cell, highlighting it if it's under
+// the cursor and coloring it by its theme intensity bucket otherwise.
+func (m *CalendarModel) renderCell(date time.Time) string {
+	count := m.calendar.Count(date)
+	if sameDay(date, m.cursor) {
+		return cursorStyle.Render(fmt.Sprintf("%2d", count)) + " "
+	}
+	if count == 0 {
+		return bucketStyle(0).Render(" ✗") + " "
+	}
+	return bucketStyle(count).Render(fmt.Sprintf("%2d", count)) + " "
+}
+
+// sameDay reports whether a and b fall on the same calendar day.
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// CompareModel fetches multiple users' contribution calendars and renders
+// them stacked one above another as rows of aligned monthly totals, with a
+// summary row of totals and streaks for each.
+type CompareModel struct {
+	state     *config.AppState
+	input     textinput.Model
+	usernames []string
+	calendars map[string]api.Calendar
+	loading   bool
+	err       error
+}
+
+// NewCompareModel returns the compare screen.
+func NewCompareModel(state *config.AppState) *CompareModel {
+	ti := textinput.New()
+	ti.Placeholder = "alice,bob,carol"
+	ti.Focus()
+	ti.CharLimit = 256
+	ti.Width = 40
+	return &CompareModel{state: state, input: ti}
+}
+
+// compareMsg carries the result of concurrently fetching every compared
+// user's contribution calendar.
+type compareMsg struct {
+	calendars map[string]api.Calendar
+	err       error
+}
+
+// fetchCompareCmd concurrently fetches usernames' calendars over the app's
+// default date window, replaying state.OfflineFixture for every user instead
+// of calling the API if one is set.
+func fetchCompareCmd(state *config.AppState, usernames []string) bubbletea.Cmd {
+	return func() bubbletea.Msg {
+		if state.OfflineFixture != "" {
+			calendars := make(map[string]api.Calendar, len(usernames))
+			for _, username := range usernames {
+				calendar, err := api.LoadFixtureCalendar(state.OfflineFixture, state.DefaultFrom, state.DefaultTo)
+				if err != nil {
+					return compareMsg{err: err}
+				}
+				calendars[username] = calendar
+			}
+			return compareMsg{calendars: calendars}
+		}
+		calendars, err := state.Client.FetchMany(usernames, state.DefaultFrom, state.DefaultTo)
+		return compareMsg{calendars: calendars, err: err}
+	}
+}
+
+// Init implements bubbletea.Model.
+func (m *CompareModel) Init() bubbletea.Cmd {
+	return textinput.Blink
+}
+
+// Update implements bubbletea.Model.
+func (m *CompareModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	switch msg := msg.(type) {
+	case bubbletea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, bubbletea.Quit
+		case "esc":
+			if m.calendars != nil {
+				m.calendars, m.usernames, m.err = nil, nil, nil
+				return m, nil
+			}
+			return m, goTo(NewHomeModel(m.state))
+		case "enter":
+			if m.calendars == nil && m.input.Value() != "" {
+				m.usernames = splitUsernames(m.input.Value())
+				m.loading = true
+				return m, fetchCompareCmd(m.state, m.usernames)
+			}
+		}
+	case compareMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.calendars = msg.calendars
+		return m, nil
+	}
+
+	if m.calendars != nil {
+		return m, nil
+	}
+	var cmd bubbletea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// splitUsernames parses a comma-separated username list, trimming
+// whitespace and dropping empty entries.
+func splitUsernames(raw string) []string {
+	var usernames []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			usernames = append(usernames, part)
+		}
+	}
+	return usernames
+}
+
+// View implements bubbletea.Model.
+func (m *CompareModel) View() string {
+	switch {
+	case m.err != nil:
+		return errorStyle.Render(fmt.Sprintf("Error: %v", m.err)) + "\n\n(esc for home)\n"
+	case m.loading:
+		return "Fetching contributions...\n"
+	case m.calendars == nil:
+		return fmt.Sprintf("Compare users\n\n%s\n\n(enter to compare, esc for home)\n", m.input.View())
+	}
+
+	max := 0
+	totalsByUser := make(map[string][]int, len(m.usernames))
+	for _, username := range m.usernames {
+		calendar, ok := m.calendars[username]
+		if !ok {
+			continue
+		}
+		totals := monthlyTotals(calendar)
+		totalsByUser[username] = totals
+		for _, total := range totals {
+			if total > max {
+				max = total
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, username := range m.usernames {
+		calendar, ok := m.calendars[username]
+		if !ok {
+			continue
+		}
+		stats := calendar.Stats()
+		b.WriteString(contributionStyle.Render(username) + "\n")
+		b.WriteString(renderMonthlyTotals(totalsByUser[username], max) + "\n")
+		b.WriteString(fmt.Sprintf(
+			"  total: %d  longest streak: %d  current streak: %d  best day: %s\n\n",
+			stats.Total, stats.LongestStreak, stats.CurrentStreak, stats.BestDayString(),
+		))
+	}
+	b.WriteString("(esc to compare a different set)\n")
+	return b.String()
+}
+
+// monthlyTotals sums calendar's contribution counts into one total per
+// calendar month in its [From, To] range.
+func monthlyTotals(calendar api.Calendar) []int {
+	var totals []int
+	monthStart := time.Date(calendar.From.Year(), calendar.From.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for !monthStart.After(calendar.To) {
+		total := 0
+		daysInMonth := monthStart.AddDate(0, 1, -1).Day()
+		for day := 1; day <= daysInMonth; day++ {
+			total += calendar.Count(time.Date(monthStart.Year(), monthStart.Month(), day, 0, 0, 0, 0, time.UTC))
+		}
+		totals = append(totals, total)
+		monthStart = monthStart.AddDate(0, 1, 0)
+	}
+	return totals
+}
+
+// renderMonthlyTotals renders one cell per entry in totals, colored by
+// intensity scaled against max — the largest monthly total across every
+// compared user — so the side-by-side comparison reads as a heatmap
+// instead of every active month saturating to the same color.
+func renderMonthlyTotals(totals []int, max int) string {
+	var b strings.Builder
+	for _, total := range totals {
+		b.WriteString(scaledBucketStyle(total, max).Render(fmt.Sprintf("%5d", total)))
+	}
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// errDetailUnavailableOffline is returned by fetchDayDetailCmd in offline
+// mode, since the fixture replay only covers contribution counts, not the
+// per-repository breakdown that requires a live commitContributionsByRepository
+// query.
+var errDetailUnavailableOffline = errors.New("per-day detail unavailable offline")
+
+// detailViewportWidth and detailViewportHeight size the scrollable region
+// used to show a day's per-repository breakdown.
+const (
+	detailViewportWidth  = 78
+	detailViewportHeight = 20
+)
+
+// DetailModel shows the per-repository commit/PR/issue breakdown for a
+// single day, scrolled via a bubbles/viewport since the list can be long.
+type DetailModel struct {
+	state    *config.AppState
+	date     time.Time
+	viewport viewport.Model
+	loading  bool
+	err      error
+}
+
+// NewDetailModel returns the day-detail screen for date.
+func NewDetailModel(state *config.AppState, date time.Time) *DetailModel {
+	return &DetailModel{
+		state:    state,
+		date:     date,
+		viewport: viewport.New(detailViewportWidth, detailViewportHeight),
+		loading:  true,
+	}
+}
+
+// dayDetailMsg carries the result of fetching a day's repository breakdown.
+type dayDetailMsg struct {
+	detail api.DayDetail
+	err    error
+}
+
+// fetchDayDetailCmd fetches the repository breakdown for date. In offline
+// mode it short-circuits with errDetailUnavailableOffline instead of
+// hitting the API, since state.Client carries no token to authenticate
+// with.
+func fetchDayDetailCmd(state *config.AppState, date time.Time) bubbletea.Cmd {
+	return func() bubbletea.Msg {
+		if state.OfflineFixture != "" {
+			return dayDetailMsg{detail: api.DayDetail{Date: date}, err: errDetailUnavailableOffline}
+		}
+		detail, err := state.Client.FetchDayDetail(state.Username, date)
+		return dayDetailMsg{detail: detail, err: err}
+	}
+}
+
+// Init implements bubbletea.Model.
+func (m *DetailModel) Init() bubbletea.Cmd {
+	return fetchDayDetailCmd(m.state, m.date)
+}
+
+// Update implements bubbletea.Model.
+func (m *DetailModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	switch msg := msg.(type) {
+	case bubbletea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, bubbletea.Quit
+		case "esc":
+			return m, goTo(NewHomeModel(m.state))
+		}
+	case dayDetailMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		m.viewport.SetContent(renderDayDetail(msg.detail))
+		return m, nil
+	}
+
+	var cmd bubbletea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View implements bubbletea.Model.
+func (m *DetailModel) View() string {
+	header := fmt.Sprintf("Activity for %s\n\n", contributionStyle.Render(m.date.Format("2006-01-02")))
+	switch {
+	case m.err != nil:
+		return header + errorStyle.Render(fmt.Sprintf("Error: %v", m.err)) + "\n"
+	case m.loading:
+		return header + "Loading...\n"
+	default:
+		return header + m.viewport.View() + "\n\n(↑/↓ to scroll, esc for home)\n"
+	}
+}
+
+// renderDayDetail formats a DayDetail's per-repository breakdown for
+// display inside the viewport.
+func renderDayDetail(detail api.DayDetail) string {
+	if len(detail.Repositories) == 0 {
+		return lightGreyStyle.Render("No activity recorded for this day.")
+	}
+	var b strings.Builder
+	for _, repo := range detail.Repositories {
+		b.WriteString(fmt.Sprintf(
+			"%s\n  commits: %d  pull requests: %d  issues: %d\n\n",
+			contributionStyle.Render(repo.Name), repo.Commits, repo.PullRequests, repo.Issues,
+		))
+	}
+	return b.String()
+}
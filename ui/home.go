@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"strings"
+
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// homeItem is a single selectable entry on the home menu.
+type homeItem struct {
+	label string
+	open  func(state *config.AppState) bubbletea.Model
+}
+
+// HomeModel is the landing screen, offering entries into the rest of the
+// application.
+type HomeModel struct {
+	state  *config.AppState
+	cursor int
+	items  []homeItem
+}
+
+// NewHomeModel returns the home menu screen, with one quick-open entry per
+// username saved in the config file ahead of the standard entries.
+func NewHomeModel(state *config.AppState) *HomeModel {
+	items := make([]homeItem, 0, len(state.Config.Users)+4)
+	for _, username := range state.Config.Users {
+		username := username
+		items = append(items, homeItem{
+			label: "Open " + username,
+			open: func(s *config.AppState) bubbletea.Model {
+				return NewUsernameModelFor(s, username)
+			},
+		})
+	}
+	items = append(items,
+		homeItem{label: "View contributions", open: func(s *config.AppState) bubbletea.Model { return NewUsernameModel(s) }},
+		homeItem{label: "Change user", open: func(s *config.AppState) bubbletea.Model { return NewUsernameModel(s) }},
+		homeItem{label: "Compare users", open: func(s *config.AppState) bubbletea.Model { return NewCompareModel(s) }},
+		homeItem{label: "Settings", open: func(s *config.AppState) bubbletea.Model { return NewSettingsModel(s) }},
+	)
+	return &HomeModel{state: state, items: items}
+}
+
+// Init implements bubbletea.Model.
+func (m *HomeModel) Init() bubbletea.Cmd {
+	return nil
+}
+
+// Update implements bubbletea.Model.
+func (m *HomeModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	keyMsg, ok := msg.(bubbletea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "q":
+		return m, bubbletea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+	case "enter":
+		return m, goTo(m.items[m.cursor].open(m.state))
+	}
+	return m, nil
+}
+
+// View implements bubbletea.Model.
+func (m *HomeModel) View() string {
+	var b strings.Builder
+	b.WriteString("GitHub Contributions\n\n")
+	for i, item := range m.items {
+		cursor := "  "
+		style := lightGreyStyle
+		if i == m.cursor {
+			cursor = "> "
+			style = contributionStyle
+		}
+		b.WriteString(cursor + style.Render(item.label) + "\n")
+	}
+	b.WriteString("\n(↑/↓ to navigate, enter to select, ctrl+c to quit)\n")
+	return b.String()
+}
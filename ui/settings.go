@@ -0,0 +1,43 @@
+package ui
+
+import (
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// SettingsModel will host the configuration screen. For now it is a
+// placeholder that returns to home on esc.
+type SettingsModel struct {
+	state *config.AppState
+}
+
+// NewSettingsModel returns the settings screen.
+func NewSettingsModel(state *config.AppState) *SettingsModel {
+	return &SettingsModel{state: state}
+}
+
+// Init implements bubbletea.Model.
+func (m *SettingsModel) Init() bubbletea.Cmd {
+	return nil
+}
+
+// Update implements bubbletea.Model.
+func (m *SettingsModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	keyMsg, ok := msg.(bubbletea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch keyMsg.String() {
+	case "ctrl+c":
+		return m, bubbletea.Quit
+	case "esc":
+		return m, goTo(NewHomeModel(m.state))
+	}
+	return m, nil
+}
+
+// View implements bubbletea.Model.
+func (m *SettingsModel) View() string {
+	return "Settings\n\nComing soon.\n\n(esc for home)\n"
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// Styles that don't vary with the contribution theme.
+var (
+	darkGreyStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#555555"))
+	lightGreyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#AAAAAA"))
+	errorStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#E85A5A"))
+	cursorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Background(lipgloss.Color("#5AABE8"))
+)
+
+// Intensity-bucket styles, set by ApplyTheme. contributionStyle tracks
+// highStyle and is used for non-calendar accents (e.g. the home menu
+// cursor).
+var (
+	noneStyle, lowStyle, midStyle, highStyle lipgloss.Style
+	contributionStyle                        lipgloss.Style
+)
+
+func init() {
+	ApplyTheme(config.DefaultTheme())
+}
+
+// ApplyTheme updates the package-level intensity styles to theme's colors.
+// Screens read these styles on every render, so calling this repaints the
+// running TUI without a restart.
+func ApplyTheme(theme config.Theme) {
+	noneStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.None))
+	lowStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Low))
+	midStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Mid))
+	highStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(theme.High))
+	contributionStyle = highStyle
+}
+
+// bucketStyle returns the intensity style for a contribution count.
+func bucketStyle(count int) lipgloss.Style {
+	switch {
+	case count == 0:
+		return noneStyle
+	case count < 3:
+		return lowStyle
+	case count < 6:
+		return midStyle
+	default:
+		return highStyle
+	}
+}
+
+// scaledBucketStyle returns the intensity style for a total whose
+// magnitude is relative to max (e.g. a monthly total, where bucketStyle's
+// single-day thresholds would saturate every active month to "high"). max
+// is the largest total being compared alongside this one.
+func scaledBucketStyle(total, max int) lipgloss.Style {
+	if total == 0 || max <= 0 {
+		return noneStyle
+	}
+	switch ratio := float64(total) / float64(max); {
+	case ratio < 1.0/3:
+		return lowStyle
+	case ratio < 2.0/3:
+		return midStyle
+	default:
+		return highStyle
+	}
+}
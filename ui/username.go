@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	bubbletea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jvanrhyn/github-contributions-tui/api"
+	"github.com/jvanrhyn/github-contributions-tui/config"
+)
+
+// UsernameModel prompts for a GitHub username and fetches their
+// contributions before handing off to the calendar screen.
+type UsernameModel struct {
+	state      *config.AppState
+	input      textinput.Model
+	autoSubmit bool
+	err        error
+}
+
+// NewUsernameModel returns the username entry screen.
+func NewUsernameModel(state *config.AppState) *UsernameModel {
+	ti := textinput.New()
+	ti.Placeholder = "Enter GitHub username"
+	ti.Focus()
+	ti.CharLimit = 156
+	ti.Width = 20
+	if state.Username != "" {
+		ti.SetValue(state.Username)
+	}
+	return &UsernameModel{state: state, input: ti}
+}
+
+// NewUsernameModelFor returns the username screen pre-filled with username
+// and set to fetch it immediately, for the home menu's saved-user
+// shortcuts.
+func NewUsernameModelFor(state *config.AppState, username string) *UsernameModel {
+	m := NewUsernameModel(state)
+	m.input.SetValue(username)
+	m.autoSubmit = true
+	return m
+}
+
+// contributionsMsg carries the result of fetching a user's contribution
+// calendar.
+type contributionsMsg struct {
+	calendar api.Calendar
+	err      error
+}
+
+// fetchContributionsCmd fetches username's contributions over the app's
+// default date window, replaying state.OfflineFixture instead of calling
+// the API if one is set.
+func fetchContributionsCmd(state *config.AppState, username string) bubbletea.Cmd {
+	return func() bubbletea.Msg {
+		if state.OfflineFixture != "" {
+			calendar, err := api.LoadFixtureCalendar(state.OfflineFixture, state.DefaultFrom, state.DefaultTo)
+			return contributionsMsg{calendar: calendar, err: err}
+		}
+		calendar, err := state.Client.FetchContributionsRange(username, state.DefaultFrom, state.DefaultTo)
+		return contributionsMsg{calendar: calendar, err: err}
+	}
+}
+
+// Init implements bubbletea.Model.
+func (m *UsernameModel) Init() bubbletea.Cmd {
+	if m.autoSubmit {
+		m.state.Username = m.input.Value()
+		return bubbletea.Batch(textinput.Blink, fetchContributionsCmd(m.state, m.state.Username))
+	}
+	return textinput.Blink
+}
+
+// Update implements bubbletea.Model.
+func (m *UsernameModel) Update(msg bubbletea.Msg) (bubbletea.Model, bubbletea.Cmd) {
+	switch msg := msg.(type) {
+	case bubbletea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, bubbletea.Quit
+		case "esc":
+			return m, goTo(NewHomeModel(m.state))
+		case "enter":
+			if m.input.Value() != "" {
+				m.state.Username = m.input.Value()
+				return m, fetchContributionsCmd(m.state, m.state.Username)
+			}
+		}
+	case contributionsMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		return m, goTo(NewCalendarModel(m.state, msg.calendar))
+	}
+
+	var cmd bubbletea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// View implements bubbletea.Model.
+func (m *UsernameModel) View() string {
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n\n%s\n", m.err, m.input.View())
+	}
+	return fmt.Sprintf("GitHub Contributions\n\n%s\n\n(enter to fetch, esc to go back)\n", m.input.View())
+}